@@ -0,0 +1,217 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tidwall/buntdb"
+)
+
+// defaultMaxEntries bounds the in-memory LRU so an unbounded stream of
+// distinct texts can't grow the cache without limit.
+const defaultMaxEntries = 10000
+
+// MemoryStore is a Store backed by BuntDB, which gives it on-disk
+// persistence and native TTL indexes, plus an LRU eviction policy capped at
+// maxEntries.
+type MemoryStore struct {
+	db         *buntdb.DB
+	maxEntries int
+
+	mu       sync.Mutex
+	order    *list.List
+	elements map[string]*list.Element
+	stats    Stats
+}
+
+// NewMemoryStore opens a BuntDB database at path (":memory:" for a
+// non-persistent store) with an LRU cap of maxEntries.
+func NewMemoryStore(path string, maxEntries int) (*MemoryStore, error) {
+	if path == "" {
+		path = ":memory:"
+	}
+	if maxEntries <= 0 {
+		maxEntries = defaultMaxEntries
+	}
+
+	db, err := buntdb.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("cache: opening buntdb at %q: %w", path, err)
+	}
+
+	s := &MemoryStore{
+		db:         db,
+		maxEntries: maxEntries,
+		order:      list.New(),
+		elements:   make(map[string]*list.Element),
+	}
+
+	// BuntDB reaps expired keys itself in the background, independently of
+	// Get/Set/Delete. Without this hook, a key that expires without ever
+	// being touched again stays in order/elements/stats.Size forever.
+	if err := db.SetConfig(buntdb.Config{OnExpired: s.onExpired}); err != nil {
+		return nil, fmt.Errorf("cache: configuring buntdb expiry callback: %w", err)
+	}
+
+	return s, nil
+}
+
+// onExpired is BuntDB's callback for keys its background sweep has found
+// past their TTL. Registering OnExpired (rather than OnExpiredSync) tells
+// BuntDB *not* to delete the keys itself, so this callback must do it —
+// otherwise the keys sit in the backing b-tree forever and the sweep
+// rediscovers them every tick. It also reaps the parallel LRU bookkeeping
+// so it reflects a key nobody will ever Get/Set/Delete again.
+func (s *MemoryStore) onExpired(keys []string) {
+	for _, key := range keys {
+		_ = s.db.Update(func(tx *buntdb.Tx) error {
+			_, err := tx.Delete(key)
+			if err == buntdb.ErrNotFound {
+				return nil
+			}
+			return err
+		})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		if el, ok := s.elements[key]; ok {
+			s.order.Remove(el)
+			delete(s.elements, key)
+			s.stats.Size--
+			s.stats.Evictions++
+		}
+	}
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string) (string, bool, error) {
+	var value string
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		v, err := tx.Get(key)
+		if err != nil {
+			return err
+		}
+		value = v
+		return nil
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil {
+		if err == buntdb.ErrNotFound {
+			s.stats.Misses++
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("cache: get %q: %w", key, err)
+	}
+
+	s.stats.Hits++
+	if el, ok := s.elements[key]; ok {
+		s.order.MoveToFront(el)
+	}
+	return value, true, nil
+}
+
+func (s *MemoryStore) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		_, _, err := tx.Set(key, value, &buntdb.SetOptions{Expires: ttl > 0, TTL: ttl})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("cache: set %q: %w", key, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if el, ok := s.elements[key]; ok {
+		s.order.MoveToFront(el)
+	} else {
+		s.elements[key] = s.order.PushFront(key)
+		s.stats.Size++
+	}
+	s.evictLocked()
+	return nil
+}
+
+// evictLocked drops the least-recently-used entries until the store is
+// back under maxEntries. Callers must hold s.mu.
+func (s *MemoryStore) evictLocked() {
+	for s.stats.Size > s.maxEntries {
+		oldest := s.order.Back()
+		if oldest == nil {
+			break
+		}
+		key := oldest.Value.(string)
+		s.order.Remove(oldest)
+		delete(s.elements, key)
+		s.stats.Size--
+		s.stats.Evictions++
+
+		_ = s.db.Update(func(tx *buntdb.Tx) error {
+			_, err := tx.Delete(key)
+			if err == buntdb.ErrNotFound {
+				return nil
+			}
+			return err
+		})
+	}
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, key string) error {
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		_, err := tx.Delete(key)
+		if err == buntdb.ErrNotFound {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("cache: delete %q: %w", key, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.elements[key]; ok {
+		s.order.Remove(el)
+		delete(s.elements, key)
+		s.stats.Size--
+	}
+	return nil
+}
+
+func (s *MemoryStore) Flush(ctx context.Context) error {
+	if err := s.db.Update(func(tx *buntdb.Tx) error {
+		return tx.DeleteAll()
+	}); err != nil {
+		return fmt.Errorf("cache: flush: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.order = list.New()
+	s.elements = make(map[string]*list.Element)
+	s.stats.Size = 0
+	return nil
+}
+
+func (s *MemoryStore) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats
+}
+
+// Ping always succeeds once the BuntDB handle is open; there's no separate
+// backing service to lose connectivity to.
+func (s *MemoryStore) Ping(ctx context.Context) error {
+	return s.db.View(func(tx *buntdb.Tx) error { return nil })
+}
+
+func (s *MemoryStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tidwall/buntdb"
+)
+
+func TestMemoryStore_LRUEviction(t *testing.T) {
+	s, err := NewMemoryStore("", 2)
+	if err != nil {
+		t.Fatalf("NewMemoryStore: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	ctx := context.Background()
+	if err := s.Set(ctx, "a", "1", 0); err != nil {
+		t.Fatalf("Set a: %v", err)
+	}
+	if err := s.Set(ctx, "b", "2", 0); err != nil {
+		t.Fatalf("Set b: %v", err)
+	}
+	if err := s.Set(ctx, "c", "3", 0); err != nil {
+		t.Fatalf("Set c: %v", err)
+	}
+
+	stats := s.Stats()
+	if stats.Size != 2 {
+		t.Fatalf("Size = %d, want 2", stats.Size)
+	}
+	if stats.Evictions != 1 {
+		t.Fatalf("Evictions = %d, want 1", stats.Evictions)
+	}
+
+	if _, ok, _ := s.Get(ctx, "a"); ok {
+		t.Fatalf("expected least-recently-used key %q to have been evicted", "a")
+	}
+}
+
+// TestMemoryStore_OnExpiredReapsBookkeeping exercises the BuntDB
+// OnExpired callback directly: it must drop the parallel LRU bookkeeping
+// for a key that expired on its own, without ever going through
+// Get/Set/Delete again, so Stats().Size doesn't leak.
+func TestMemoryStore_OnExpiredReapsBookkeeping(t *testing.T) {
+	s, err := NewMemoryStore("", 10)
+	if err != nil {
+		t.Fatalf("NewMemoryStore: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	ctx := context.Background()
+	if err := s.Set(ctx, "a", "1", time.Millisecond); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if got := s.Stats().Size; got != 1 {
+		t.Fatalf("Size after Set = %d, want 1", got)
+	}
+
+	// Simulate BuntDB's background sweep reaping the expired key, which
+	// happens independently of any Get/Set/Delete call.
+	s.onExpired([]string{"a"})
+
+	stats := s.Stats()
+	if stats.Size != 0 {
+		t.Fatalf("Size after expiry = %d, want 0 (bookkeeping leaked)", stats.Size)
+	}
+	if stats.Evictions != 1 {
+		t.Fatalf("Evictions after expiry = %d, want 1", stats.Evictions)
+	}
+
+	// A second callback for the same, already-reaped key must be a no-op
+	// rather than double-decrementing Size.
+	s.onExpired([]string{"a"})
+	if got := s.Stats().Size; got != 0 {
+		t.Fatalf("Size after redundant expiry callback = %d, want 0", got)
+	}
+}
+
+// TestMemoryStore_BackgroundExpiryDeletesFromBuntDB waits out a real TTL
+// through BuntDB's own background sweep (not a direct onExpired call), and
+// asserts the key is actually gone from the underlying store, not just the
+// LRU bookkeeping. Registering OnExpired (rather than OnExpiredSync) tells
+// BuntDB not to delete the key itself, so onExpired must do it or the key
+// lives in the b-tree forever and the sweep keeps rediscovering it.
+func TestMemoryStore_BackgroundExpiryDeletesFromBuntDB(t *testing.T) {
+	s, err := NewMemoryStore("", 10)
+	if err != nil {
+		t.Fatalf("NewMemoryStore: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	ctx := context.Background()
+	if err := s.Set(ctx, "a", "1", 10*time.Millisecond); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if s.Stats().Size == 0 {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	if got := s.Stats().Size; got != 0 {
+		t.Fatalf("Size after waiting out background expiry = %d, want 0", got)
+	}
+
+	err = s.db.View(func(tx *buntdb.Tx) error {
+		// ignoreExpired=true: an expired key still in the b-tree is found.
+		_, err := tx.Get("a", true)
+		return err
+	})
+	if err != buntdb.ErrNotFound {
+		t.Fatalf("expected key to be deleted from the underlying store, db.Get returned err=%v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStore is a Store backed by Redis, so every Fiber prefork worker (and
+// every instance of the service) shares one cache instead of each having
+// its own disjoint copy.
+type RedisStore struct {
+	client *redis.Client
+	hits   uint64
+	misses uint64
+}
+
+// NewRedisStore builds a RedisStore against the given address, optional
+// password, and database index.
+func NewRedisStore(addr, password string, db int) *RedisStore {
+	return &RedisStore{
+		client: redis.NewClient(&redis.Options{
+			Addr:     addr,
+			Password: password,
+			DB:       db,
+		}),
+	}
+}
+
+func (s *RedisStore) Get(ctx context.Context, key string) (string, bool, error) {
+	value, err := s.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		atomic.AddUint64(&s.misses, 1)
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("cache: redis get %q: %w", key, err)
+	}
+	atomic.AddUint64(&s.hits, 1)
+	return value, true, nil
+}
+
+func (s *RedisStore) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	if err := s.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("cache: redis set %q: %w", key, err)
+	}
+	return nil
+}
+
+func (s *RedisStore) Delete(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("cache: redis delete %q: %w", key, err)
+	}
+	return nil
+}
+
+func (s *RedisStore) Flush(ctx context.Context) error {
+	if err := s.client.FlushDB(ctx).Err(); err != nil {
+		return fmt.Errorf("cache: redis flush: %w", err)
+	}
+	return nil
+}
+
+// Stats reports hit/miss counters tracked locally and the current key
+// count from Redis. Redis manages its own eviction, so Evictions is always
+// zero here.
+func (s *RedisStore) Stats() Stats {
+	size, _ := s.client.DBSize(context.Background()).Result()
+	return Stats{
+		Hits:   atomic.LoadUint64(&s.hits),
+		Misses: atomic.LoadUint64(&s.misses),
+		Size:   int(size),
+	}
+}
+
+// Ping checks that the Redis server is reachable, for /readyz.
+func (s *RedisStore) Ping(ctx context.Context) error {
+	if err := s.client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("cache: redis ping: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,87 @@
+// Package cache provides a pluggable TTL key/value store for translated
+// content. Fiber runs with Prefork, so each worker process has its own
+// memory space; an in-process map alone gives every worker a disjoint
+// cache. Store lets that backing choice (in-memory, Redis, ...) be made
+// independently of the callers that use it.
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Stats reports cache effectiveness for the /api/cache/stats endpoint.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Size      int
+	Evictions uint64
+}
+
+// Store is a TTL key/value cache for translated content.
+type Store interface {
+	// Get returns the cached value for key, or ok=false if absent or expired.
+	Get(ctx context.Context, key string) (value string, ok bool, err error)
+	// Set stores value under key with the given TTL. ttl <= 0 means no expiry.
+	Set(ctx context.Context, key, value string, ttl time.Duration) error
+	// Delete removes a single key.
+	Delete(ctx context.Context, key string) error
+	// Flush removes every key, for DELETE /api/cache.
+	Flush(ctx context.Context) error
+	// Stats reports hit/miss/size/eviction counters.
+	Stats() Stats
+	// Ping reports whether the backing store is reachable, for /readyz.
+	Ping(ctx context.Context) error
+	// Close releases any underlying resources.
+	Close() error
+}
+
+// HashKey derives a stable cache key from normalized text and a target
+// language. It replaces the old hashText, which returned len(text) and so
+// collided for any two texts of equal length.
+func HashKey(text, targetLang string) string {
+	normalized := strings.Join(strings.Fields(strings.ToLower(text)), " ")
+	sum := sha256.Sum256([]byte(normalized + "|" + targetLang))
+	return hex.EncodeToString(sum[:])
+}
+
+// New builds a Store selected by the CACHE_BACKEND env var ("memory", the
+// default, or "redis"), configured from the corresponding CACHE_* /
+// REDIS_* env vars.
+func New() (Store, error) {
+	switch backend := os.Getenv("CACHE_BACKEND"); backend {
+	case "", "memory":
+		path := os.Getenv("CACHE_PATH")
+		maxEntries := defaultMaxEntries
+		if v := os.Getenv("CACHE_MAX_ENTRIES"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("cache: invalid CACHE_MAX_ENTRIES %q: %w", v, err)
+			}
+			maxEntries = n
+		}
+		return NewMemoryStore(path, maxEntries)
+	case "redis":
+		addr := os.Getenv("REDIS_ADDR")
+		if addr == "" {
+			addr = "localhost:6379"
+		}
+		db := 0
+		if v := os.Getenv("REDIS_DB"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("cache: invalid REDIS_DB %q: %w", v, err)
+			}
+			db = n
+		}
+		return NewRedisStore(addr, os.Getenv("REDIS_PASSWORD"), db), nil
+	default:
+		return nil, fmt.Errorf("cache: unknown CACHE_BACKEND %q", backend)
+	}
+}
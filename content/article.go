@@ -0,0 +1,44 @@
+// Package content isolates a page's main article body before translation,
+// so navigation, ads, and footers don't waste tokens on the upstream
+// translation provider.
+package content
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+
+	"github.com/go-shiori/go-readability"
+)
+
+// Article is the structured result of extracting a page's main content.
+type Article struct {
+	Title   string
+	Byline  string
+	Content string
+	Lang    string
+	Excerpt string
+}
+
+// Extract runs body through readability to isolate the article title,
+// byline, language, excerpt, and main text content. pageURL is used to
+// resolve relative links and images within the document.
+func Extract(body []byte, pageURL string) (Article, error) {
+	u, err := url.Parse(pageURL)
+	if err != nil {
+		return Article{}, fmt.Errorf("content: parsing page URL: %w", err)
+	}
+
+	parsed, err := readability.FromReader(bytes.NewReader(body), u)
+	if err != nil {
+		return Article{}, fmt.Errorf("content: extracting article: %w", err)
+	}
+
+	return Article{
+		Title:   parsed.Title,
+		Byline:  parsed.Byline,
+		Content: parsed.TextContent,
+		Lang:    parsed.Language,
+		Excerpt: parsed.Excerpt,
+	}, nil
+}
@@ -0,0 +1,222 @@
+// Package httpclient is the one code path every outbound HTTP call in this
+// service goes through. It centralizes auth headers, user-agent, JSON
+// decoding, and status-code handling, and wraps each call with exponential
+// retry/backoff on 429/5xx plus a per-attempt deadline that's independent of
+// the caller's overall context budget.
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+const (
+	defaultUserAgent      = "Translator-API-Golang/1.0"
+	defaultMaxAttempts    = 3
+	defaultAttemptTimeout = 10 * time.Second
+)
+
+// OnResponse is called after every attempt (successful or not) so callers
+// can hook in metrics. status is 0 if the request never got a response.
+type OnResponse func(label string, duration time.Duration, status int, err error)
+
+// Client is a shared HTTP client with retry/backoff and a consistent
+// request-building surface.
+type Client struct {
+	http           *http.Client
+	userAgent      string
+	maxAttempts    uint64
+	attemptTimeout time.Duration
+	onResponse     OnResponse
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithUserAgent overrides the default User-Agent header.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) { c.userAgent = ua }
+}
+
+// WithMaxAttempts sets how many times a request is attempted in total
+// (including the first try) before giving up.
+func WithMaxAttempts(n int) Option {
+	return func(c *Client) { c.maxAttempts = uint64(n) }
+}
+
+// WithAttemptTimeout bounds a single attempt, independent of the overall
+// context deadline the caller supplies to Do.
+func WithAttemptTimeout(d time.Duration) Option {
+	return func(c *Client) { c.attemptTimeout = d }
+}
+
+// WithOnResponse registers a metrics hook called after every attempt.
+func WithOnResponse(fn OnResponse) Option {
+	return func(c *Client) { c.onResponse = fn }
+}
+
+// New builds a Client. The zero-value http.Client is used for transport
+// unless overridden by a future option; timeouts are enforced per-attempt
+// via context instead of http.Client.Timeout so they compose with retries.
+func New(opts ...Option) *Client {
+	c := &Client{
+		http:           &http.Client{},
+		userAgent:      defaultUserAgent,
+		maxAttempts:    defaultMaxAttempts,
+		attemptTimeout: defaultAttemptTimeout,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// RequestBuilder accumulates the pieces of an outbound request.
+type RequestBuilder struct {
+	client *Client
+	method string
+	url    string
+	header http.Header
+	body   []byte
+	label  string
+}
+
+// NewRequest starts building a request. label identifies the call site for
+// the OnResponse metrics hook (e.g. "deepseek.translate").
+func (c *Client) NewRequest(method, url, label string) *RequestBuilder {
+	return &RequestBuilder{
+		client: c,
+		method: method,
+		url:    url,
+		header: http.Header{},
+		label:  label,
+	}
+}
+
+// Header adds a header to the outbound request.
+func (b *RequestBuilder) Header(key, value string) *RequestBuilder {
+	b.header.Add(key, value)
+	return b
+}
+
+// Bearer sets the Authorization header to "Bearer <token>".
+func (b *RequestBuilder) Bearer(token string) *RequestBuilder {
+	return b.Header("Authorization", "Bearer "+token)
+}
+
+// JSON marshals v as the request body and sets the appropriate headers.
+func (b *RequestBuilder) JSON(v interface{}) *RequestBuilder {
+	data, err := json.Marshal(v)
+	if err != nil {
+		// Recorded and surfaced once Do is called; keeping JSON chainable
+		// (no error return) matches the rest of the builder's API.
+		b.body = nil
+		b.header.Set("X-Httpclient-Marshal-Error", err.Error())
+		return b
+	}
+	b.body = data
+	b.header.Set("Content-Type", "application/json")
+	b.header.Set("Accept", "application/json")
+	return b
+}
+
+// Do executes the request, retrying on 429/5xx with exponential backoff
+// until ctx is done or the attempt budget is exhausted. If out is non-nil,
+// a successful response body is JSON-decoded into it.
+func (b *RequestBuilder) Do(ctx context.Context, out interface{}) error {
+	if msg := b.header.Get("X-Httpclient-Marshal-Error"); msg != "" {
+		return fmt.Errorf("httpclient: encoding %s request body: %s", b.label, msg)
+	}
+
+	data, err := b.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("httpclient: decoding %s response: %w", b.label, err)
+	}
+	return nil
+}
+
+// Bytes executes the request and returns the raw response body instead of
+// decoding JSON, for callers like extractArticleFromURL that need the body
+// as-is.
+func (b *RequestBuilder) Bytes(ctx context.Context) ([]byte, error) {
+	return b.fetch(ctx)
+}
+
+// fetch runs one or more attempts of the request under exponential
+// backoff, returning the successful response body.
+func (b *RequestBuilder) fetch(ctx context.Context) ([]byte, error) {
+	var result []byte
+
+	operation := func() error {
+		attemptCtx, cancel := context.WithTimeout(ctx, b.client.attemptTimeout)
+		defer cancel()
+
+		var bodyReader io.Reader
+		if b.body != nil {
+			bodyReader = bytes.NewReader(b.body)
+		}
+
+		req, err := http.NewRequestWithContext(attemptCtx, b.method, b.url, bodyReader)
+		if err != nil {
+			return backoff.Permanent(fmt.Errorf("httpclient: building %s request: %w", b.label, err))
+		}
+		req.Header = b.header.Clone()
+		if b.client.userAgent != "" {
+			req.Header.Set("User-Agent", b.client.userAgent)
+		}
+
+		start := time.Now()
+		resp, err := b.client.http.Do(req)
+		duration := time.Since(start)
+
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		if b.client.onResponse != nil {
+			b.client.onResponse(b.label, duration, status, err)
+		}
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return backoff.Permanent(fmt.Errorf("httpclient: %s request: %w", b.label, err))
+			}
+			return fmt.Errorf("httpclient: %s request: %w", b.label, err)
+		}
+		defer resp.Body.Close()
+
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return backoff.Permanent(fmt.Errorf("httpclient: reading %s response: %w", b.label, err))
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			return fmt.Errorf("httpclient: %s retryable status %s: %s", b.label, resp.Status, string(data))
+		}
+		if resp.StatusCode >= 400 {
+			return backoff.Permanent(fmt.Errorf("httpclient: %s status %s: %s", b.label, resp.Status, string(data)))
+		}
+
+		result = data
+		return nil
+	}
+
+	policy := backoff.WithContext(backoff.WithMaxRetries(backoff.NewExponentialBackOff(), b.client.maxAttempts-1), ctx)
+	if err := backoff.Retry(operation, policy); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -1,12 +1,9 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
-	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"strings"
@@ -14,34 +11,55 @@ import (
 	"time"
 
 	"github.com/gofiber/fiber/v2"
-	"golang.org/x/net/html"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/LesterCerioli/Translator-API-Golang/cache"
+	"github.com/LesterCerioli/Translator-API-Golang/content"
+	"github.com/LesterCerioli/Translator-API-Golang/httpclient"
+	"github.com/LesterCerioli/Translator-API-Golang/telemetry"
+	"github.com/LesterCerioli/Translator-API-Golang/translator"
 )
 
 const (
-	DeepSeekAPIURL  = "https://api.deepseek.com/v1/translate"
 	CacheDuration   = 24 * time.Hour
-	MaxTextLength   = 5000
 	DefaultLanguage = "en"
-	RequestTimeout  = 15 * time.Second
+	DefaultEngine   = "deepseek"
+	WorkerPoolSize  = 32
 )
 
 var (
-	translationCache sync.Map
-	cacheMutex       sync.RWMutex
-	apiKey           = os.Getenv("DEEPSEEK_API_KEY")
+	store       cache.Store
+	pools       = map[string]*translator.Pool{}
+	poolsMutex  sync.Mutex
+	fetchClient = httpclient.New()
 )
 
-type CacheEntry struct {
-	Content    string
-	Expiration time.Time
-}
-
 func main() {
+	telemetry.InitLogging()
+
+	shutdownTracing, err := telemetry.InitTracing(context.Background())
+	if err != nil {
+		slog.Error("failed to initialize tracing", "error", err)
+		os.Exit(1)
+	}
+	defer shutdownTracing(context.Background())
+
+	store, err = cache.New()
+	if err != nil {
+		slog.Error("failed to initialize cache store", "error", err)
+		os.Exit(1)
+	}
+	defer store.Close()
+
 	app := fiber.New(fiber.Config{
 		Prefork:       true,
 		CaseSensitive: true,
 	})
 
+	app.Use(requestid.New())
+
 	// Middleware to detect language
 	app.Use(func(c *fiber.Ctx) error {
 		lang := DefaultLanguage
@@ -58,19 +76,106 @@ func main() {
 		return c.Next()
 	})
 
-	// Route for explicit translation API
+	// Route for explicit translation API (URL-based, extracted article body)
 	app.Get("/api/translate", handleTranslateAPI)
+	// Route for direct text translation
+	app.Post("/api/translate", handleTranslateText)
+	// Route listing available engines and their supported languages
+	app.Get("/api/engines", handleEngines)
+	// Cache observability and management
+	app.Get("/api/cache/stats", handleCacheStats)
+	app.Delete("/api/cache", handleCacheDelete)
+
+	// Observability
+	app.Get("/metrics", adaptor.HTTPHandler(promhttp.Handler()))
+	app.Get("/healthz", handleHealthz)
+	app.Get("/readyz", handleReadyz)
 
 	// Route for automatic translation proxy
 	app.Get("/*", handleAutomaticTranslation)
 
-	log.Println("Translation server started on port :8080")
+	slog.Info("translation server started", "port", 3080)
 	app.Listen(":3080")
 }
 
+// requestContext derives a context from c carrying the request ID assigned
+// by the requestid middleware, so downstream logging and tracing can
+// correlate a whole request's log lines and spans.
+func requestContext(c *fiber.Ctx) context.Context {
+	id, _ := c.Locals(requestid.ConfigDefault.ContextKey).(string)
+	return telemetry.WithRequestID(c.Context(), id)
+}
+
+// handleHealthz reports whether the service is configured to serve
+// translations at all: it checks that DEEPSEEK_API_KEY is set, since the
+// default engine can't do anything without it.
+func handleHealthz(c *fiber.Ctx) error {
+	if os.Getenv("DEEPSEEK_API_KEY") == "" {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"status": "unhealthy",
+			"error":  "DEEPSEEK_API_KEY is not configured",
+		})
+	}
+	return c.JSON(fiber.Map{"status": "ok"})
+}
+
+// handleReadyz reports whether the service's dependencies are reachable,
+// specifically the configured cache backend.
+func handleReadyz(c *fiber.Ctx) error {
+	if err := store.Ping(c.Context()); err != nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"status": "not ready",
+			"error":  err.Error(),
+		})
+	}
+	return c.JSON(fiber.Map{"status": "ready"})
+}
+
+// enginePool returns the (lazily created) worker pool for the named engine.
+func enginePool(engine string) (*translator.Pool, error) {
+	poolsMutex.Lock()
+	defer poolsMutex.Unlock()
+
+	if pool, ok := pools[engine]; ok {
+		return pool, nil
+	}
+
+	provider, err := translator.Get(engine)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := translator.NewPool(provider, WorkerPoolSize, store, CacheDuration)
+	if err != nil {
+		return nil, err
+	}
+	pools[engine] = pool
+	return pool, nil
+}
+
+func handleCacheStats(c *fiber.Ctx) error {
+	stats := store.Stats()
+	return c.JSON(fiber.Map{
+		"hits":      stats.Hits,
+		"misses":    stats.Misses,
+		"size":      stats.Size,
+		"evictions": stats.Evictions,
+	})
+}
+
+func handleCacheDelete(c *fiber.Ctx) error {
+	if err := store.Flush(c.Context()); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.JSON(fiber.Map{"flushed": true})
+}
+
 func handleTranslateAPI(c *fiber.Ctx) error {
+	ctx := requestContext(c)
 	url := c.Query("url")
 	lang := c.Locals("lang").(string)
+	engine := c.Query("engine", DefaultEngine)
 
 	if url == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
@@ -78,7 +183,7 @@ func handleTranslateAPI(c *fiber.Ctx) error {
 		})
 	}
 
-	if cached, ok := getFromCache(url, lang); ok {
+	if cached, ok := getFromCache(ctx, url, lang); ok {
 		return c.JSON(fiber.Map{
 			"cached":     true,
 			"original":   cached,
@@ -87,31 +192,116 @@ func handleTranslateAPI(c *fiber.Ctx) error {
 		})
 	}
 
-	content, err := extractContentFromURL(url)
+	article, err := extractArticleFromURL(ctx, url)
 	if err != nil {
+		telemetry.Logger(ctx).Error("extracting content", "error", err)
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "Failed to extract content: " + err.Error(),
 		})
 	}
 
-	translated, err := translateWithDeepSeek(content, lang)
+	pool, err := enginePool(engine)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	translated, err := translateText(ctx, pool, article.Content, article.Lang, lang)
 	if err != nil {
+		telemetry.Logger(ctx).Error("translating article", "error", err)
 		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
 			"error": "Translation service unavailable: " + err.Error(),
 		})
 	}
 
-	addToCache(url, lang, translated)
+	addToCache(ctx, url, lang, translated)
 
 	return c.JSON(fiber.Map{
-		"cached":     false,
-		"original":   content,
-		"translated": translated,
-		"language":   lang,
+		"cached":          false,
+		"original":        article.Content,
+		"translated":      translated,
+		"language":        lang,
+		"title":           article.Title,
+		"byline":          article.Byline,
+		"excerpt":         article.Excerpt,
+		"source_language": article.Lang,
 	})
 }
 
+type translateTextRequest struct {
+	Text   string `json:"text"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Engine string `json:"engine"`
+}
+
+func handleTranslateText(c *fiber.Ctx) error {
+	var req translateTextRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body: " + err.Error(),
+		})
+	}
+
+	if strings.TrimSpace(req.Text) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "text is required",
+		})
+	}
+	if req.Target == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "target is required",
+		})
+	}
+	if req.Engine == "" {
+		req.Engine = DefaultEngine
+	}
+
+	provider, err := translator.Get(req.Engine)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	result, err := provider.Translate(requestContext(c), translator.Request{
+		Text:   req.Text,
+		Source: req.Source,
+		Target: req.Target,
+	})
+	if err != nil {
+		telemetry.Logger(requestContext(c)).Error("translating text", "error", err)
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "translation service unavailable: " + err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"original":   req.Text,
+		"translated": result.Text,
+		"engine":     req.Engine,
+		"source":     req.Source,
+		"target":     req.Target,
+	})
+}
+
+func handleEngines(c *fiber.Ctx) error {
+	providers := translator.List()
+	out := make([]fiber.Map, 0, len(providers))
+	for _, p := range providers {
+		source, target := p.Languages()
+		out = append(out, fiber.Map{
+			"name":   p.Name(),
+			"source": source,
+			"target": target,
+		})
+	}
+	return c.JSON(fiber.Map{"engines": out})
+}
+
 func handleAutomaticTranslation(c *fiber.Ctx) error {
+	ctx := requestContext(c)
 	lang := c.Locals("lang").(string)
 	requestedURL := c.OriginalURL()
 
@@ -123,7 +313,7 @@ func handleAutomaticTranslation(c *fiber.Ctx) error {
 		return c.Next()
 	}
 
-	if cached, ok := getFromCache(requestedURL, lang); ok {
+	if cached, ok := getFromCache(ctx, requestedURL, lang); ok {
 		return c.SendString(cached)
 	}
 
@@ -139,168 +329,58 @@ func handleAutomaticTranslation(c *fiber.Ctx) error {
 
 	body := c.Response().Body()
 
-	translatedHTML, err := processHTML(string(body), lang)
+	pool, err := enginePool(DefaultEngine)
 	if err != nil {
-		log.Printf("Error processing HTML: %v", err)
+		telemetry.Logger(ctx).Error("getting engine pool", "error", err)
 		return c.SendString(string(body))
 	}
 
-	addToCache(requestedURL, lang, translatedHTML)
-
-	return c.SendString(translatedHTML)
-}
-
-func processHTML(htmlContent string, lang string) (string, error) {
-	doc, err := html.Parse(strings.NewReader(htmlContent))
+	translatedHTML, err := translator.ProcessHTML(ctx, pool, string(body), "", lang)
 	if err != nil {
-		return "", fmt.Errorf("error parsing HTML: %w", err)
-	}
-
-	var processNode func(*html.Node)
-	processNode = func(n *html.Node) {
-		if n.Type == html.TextNode && strings.TrimSpace(n.Data) != "" {
-			translated, err := translateWithDeepSeek(n.Data, lang)
-			if err == nil {
-				n.Data = translated
-			}
-		}
-
-		for c := n.FirstChild; c != nil; c = c.NextSibling {
-			processNode(c)
-		}
+		telemetry.RecordError("html_processing")
+		telemetry.Logger(ctx).Error("processing HTML", "error", err)
+		return c.SendString(string(body))
 	}
 
-	processNode(doc)
+	addToCache(ctx, requestedURL, lang, translatedHTML)
 
-	var buf bytes.Buffer
-	if err := html.Render(&buf, doc); err != nil {
-		return "", fmt.Errorf("error rendering HTML: %w", err)
-	}
-
-	return buf.String(), nil
+	return c.SendString(translatedHTML)
 }
 
-func translateWithDeepSeek(text string, targetLang string) (string, error) {
-
-	if len(strings.TrimSpace(text)) == 0 {
+// translateText translates a block of plain text through pool as a single
+// segment, so it goes through the pool's cache. The text is not split: a
+// long article is sent upstream in one call.
+func translateText(ctx context.Context, pool *translator.Pool, text, source, target string) (string, error) {
+	if strings.TrimSpace(text) == "" {
 		return text, nil
 	}
-
-	cacheKey := "text_" + targetLang + "_" + hashText(text)
-	if cached, ok := getFromCache(cacheKey, ""); ok {
-		return cached, nil
-	}
-
-	if len(text) > MaxTextLength {
-		text = text[:MaxTextLength]
-	}
-
-	translated, err := callDeepSeekAPI(text, targetLang)
-	if err != nil {
-		return "", fmt.Errorf("translation error: %w", err)
-	}
-
-	addToCache(cacheKey, "", translated)
-	return translated, nil
-}
-
-func callDeepSeekAPI(text, targetLang string) (string, error) {
-	if apiKey == "" {
-		return "", errors.New("API key not configured")
-	}
-
-	payload := map[string]interface{}{
-		"text":        text,
-		"target_lang": targetLang,
-	}
-
-	jsonData, err := json.Marshal(payload)
-	if err != nil {
-		return "", fmt.Errorf("error serializing payload: %w", err)
-	}
-
-	req, err := http.NewRequest("POST", DeepSeekAPIURL, bytes.NewBuffer(jsonData))
+	out, err := pool.TranslateBatch(ctx, []translator.Segment{{Index: 0, Text: text}}, source, target)
 	if err != nil {
-		return "", fmt.Errorf("error creating request: %w", err)
-	}
-
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-
-	client := &http.Client{Timeout: RequestTimeout}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("HTTP request error: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("API error: %s - %s", resp.Status, string(body))
+		return "", err
 	}
-
-	var result struct {
-		Data struct {
-			Translations []struct {
-				Text string `json:"text"`
-			} `json:"translations"`
-		} `json:"data"`
-	}
-
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return "", fmt.Errorf("error decoding response: %w", err)
-	}
-
-	if len(result.Data.Translations) == 0 {
-		return "", errors.New("no translations returned")
-	}
-
-	return result.Data.Translations[0].Text, nil
+	return out[0], nil
 }
 
-func extractContentFromURL(url string) (string, error) {
-	resp, err := http.Get(url)
-	if err != nil {
-		return "", fmt.Errorf("error accessing URL: %w", err)
-	}
-	defer resp.Body.Close()
+// extractArticleFromURL fetches pageURL and isolates its main article body
+// via readability, instead of concatenating every text node on the page
+// (which drags navigation, ads, and footers along for translation).
+func extractArticleFromURL(ctx context.Context, pageURL string) (content.Article, error) {
+	ctx, span := telemetry.Tracer().Start(ctx, "content.extract")
+	defer span.End()
 
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("non-OK status code: %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
+	body, err := fetchClient.NewRequest(http.MethodGet, pageURL, "extract.fetch").Bytes(ctx)
 	if err != nil {
-		return "", fmt.Errorf("error reading response body: %w", err)
+		telemetry.RecordError("extract_fetch")
+		return content.Article{}, fmt.Errorf("error accessing URL: %w", err)
 	}
 
-	doc, err := html.Parse(bytes.NewReader(body))
+	article, err := content.Extract(body, pageURL)
 	if err != nil {
-		return "", fmt.Errorf("error parsing HTML: %w", err)
-	}
-
-	return extractText(doc), nil
-}
-
-func extractText(n *html.Node) string {
-	var sb strings.Builder
-	var f func(*html.Node)
-
-	f = func(n *html.Node) {
-		if n.Type == html.TextNode {
-			text := strings.TrimSpace(n.Data)
-			if text != "" {
-				sb.WriteString(text + " ")
-			}
-		}
-		for c := n.FirstChild; c != nil; c = c.NextSibling {
-			f(c)
-		}
+		telemetry.RecordError("extract_parse")
+		return content.Article{}, err
 	}
 
-	f(n)
-	return strings.TrimSpace(sb.String())
+	return article, nil
 }
 
 func detectPreferredLanguage(header string) string {
@@ -322,41 +402,31 @@ func detectPreferredLanguage(header string) string {
 	return DefaultLanguage
 }
 
-func addToCache(key, lang, content string) {
+func addToCache(ctx context.Context, key, lang, value string) {
 	cacheKey := key
 	if lang != "" {
 		cacheKey += "|" + lang
 	}
 
-	cacheMutex.Lock()
-	translationCache.Store(cacheKey, CacheEntry{
-		Content:    content,
-		Expiration: time.Now().Add(CacheDuration),
-	})
-	cacheMutex.Unlock()
+	if err := store.Set(ctx, cacheKey, value, CacheDuration); err != nil {
+		telemetry.RecordError("cache_write")
+		telemetry.Logger(ctx).Error("writing to cache", "error", err)
+	}
 }
 
-func getFromCache(key, lang string) (string, bool) {
+func getFromCache(ctx context.Context, key, lang string) (string, bool) {
 	cacheKey := key
 	if lang != "" {
 		cacheKey += "|" + lang
 	}
 
-	cacheMutex.RLock()
-	defer cacheMutex.RUnlock()
-
-	if entry, ok := translationCache.Load(cacheKey); ok {
-		cached := entry.(CacheEntry)
-		if time.Now().Before(cached.Expiration) {
-			return cached.Content, true
-		}
+	value, ok, err := store.Get(ctx, cacheKey)
+	if err != nil {
+		telemetry.RecordError("cache_read")
+		telemetry.Logger(ctx).Error("reading from cache", "error", err)
+		return "", false
 	}
-	return "", false
-}
-
-func hashText(text string) string {
-
-	return fmt.Sprintf("%d", len(text))
+	return value, ok
 }
 
 func isStaticFile(path string) bool {
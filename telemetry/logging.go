@@ -0,0 +1,32 @@
+package telemetry
+
+import (
+	"context"
+	"log/slog"
+	"os"
+)
+
+type requestIDKey struct{}
+
+// InitLogging installs a JSON slog handler as the default logger, so every
+// log line across the service (including from packages that just call
+// slog.Default()) carries consistent structured fields.
+func InitLogging() {
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})
+	slog.SetDefault(slog.New(handler))
+}
+
+// WithRequestID returns a context carrying requestID for later retrieval by
+// Logger, so request-scoped log lines can be correlated.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// Logger returns the default logger, augmented with the request ID stored
+// in ctx (if any) via WithRequestID.
+func Logger(ctx context.Context) *slog.Logger {
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok && id != "" {
+		return slog.Default().With("request_id", id)
+	}
+	return slog.Default()
+}
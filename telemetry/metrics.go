@@ -0,0 +1,87 @@
+// Package telemetry centralizes the service's observability surface:
+// Prometheus metrics, OpenTelemetry tracing, and structured logging, so
+// main.go and the translator/cache packages share one consistent story
+// instead of each bolting on its own log.Printf calls.
+package telemetry
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// TranslationRequests counts translation attempts by engine and outcome
+// ("ok" or "error"), for request-volume and error-rate dashboards.
+var TranslationRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "translator_requests_total",
+	Help: "Translation requests handled, by engine and outcome.",
+}, []string{"engine", "outcome"})
+
+// CacheLookups counts cache reads by result ("hit" or "miss"), the basis
+// for the cache hit ratio.
+var CacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "translator_cache_lookups_total",
+	Help: "Cache lookups, by result (hit or miss).",
+}, []string{"result"})
+
+// UpstreamLatency observes how long each provider's HTTP call takes,
+// labeled by the httpclient request label (e.g. "deepseek.translate").
+var UpstreamLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "translator_upstream_latency_seconds",
+	Help:    "Latency of outbound calls to translation providers, by call label.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"label"})
+
+// CharsTranslated counts characters translated, by source/target language
+// pair, for usage and cost reporting.
+var CharsTranslated = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "translator_chars_translated_total",
+	Help: "Characters translated, by source and target language.",
+}, []string{"source", "target"})
+
+// Errors counts failures by class (e.g. "upstream", "cache_read",
+// "extract_fetch"),
+// so error rates can be broken down by where they originated.
+var Errors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "translator_errors_total",
+	Help: "Errors encountered, by class.",
+}, []string{"class"})
+
+// RecordCacheLookup records a cache hit or miss.
+func RecordCacheLookup(hit bool) {
+	if hit {
+		CacheLookups.WithLabelValues("hit").Inc()
+		return
+	}
+	CacheLookups.WithLabelValues("miss").Inc()
+}
+
+// RecordTranslation records the outcome of a translation request and, on
+// success, the volume of text translated for the given language pair.
+func RecordTranslation(engine, source, target string, chars int, err error) {
+	outcome := "ok"
+	if err != nil {
+		outcome = "error"
+	}
+	TranslationRequests.WithLabelValues(engine, outcome).Inc()
+	if err == nil && chars > 0 {
+		CharsTranslated.WithLabelValues(source, target).Add(float64(chars))
+	}
+}
+
+// RecordError increments the error counter for the given class.
+func RecordError(class string) {
+	Errors.WithLabelValues(class).Inc()
+}
+
+// OnUpstreamResponse is an httpclient.OnResponse hook that records per-call
+// latency and counts failed attempts (transport errors and 4xx/5xx
+// responses) under the "upstream" error class, for wiring into every
+// provider's Client.
+func OnUpstreamResponse(label string, duration time.Duration, status int, err error) {
+	UpstreamLatency.WithLabelValues(label).Observe(duration.Seconds())
+	if err != nil || status >= 400 {
+		RecordError("upstream")
+	}
+}
@@ -0,0 +1,54 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/LesterCerioli/Translator-API-Golang"
+
+// InitTracing configures the global OpenTelemetry trace provider to export
+// via OTLP/HTTP when OTEL_EXPORTER_OTLP_ENDPOINT is set. With no endpoint
+// configured it leaves the no-op provider in place, so Tracer() spans are
+// cheap no-ops in environments (local dev, CI) that don't run a collector.
+// The returned shutdown func flushes pending spans and should be deferred.
+func InitTracing(ctx context.Context) (shutdown func(context.Context) error, err error) {
+	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("telemetry: creating OTLP exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName("translator-api"),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("telemetry: building resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// Tracer returns the service's tracer. Before InitTracing runs (or when no
+// OTLP endpoint is configured), this resolves to the global no-op tracer.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
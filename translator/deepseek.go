@@ -0,0 +1,75 @@
+package translator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+
+	"github.com/LesterCerioli/Translator-API-Golang/httpclient"
+	"github.com/LesterCerioli/Translator-API-Golang/telemetry"
+)
+
+const deepSeekAPIURL = "https://api.deepseek.com/v1/translate"
+
+// DeepSeekProvider talks to the DeepSeek translation API.
+type DeepSeekProvider struct {
+	apiKey string
+	client *httpclient.Client
+}
+
+// NewDeepSeekProvider builds a DeepSeekProvider, reading the API key from
+// DEEPSEEK_API_KEY.
+func NewDeepSeekProvider() *DeepSeekProvider {
+	return &DeepSeekProvider{
+		apiKey: os.Getenv("DEEPSEEK_API_KEY"),
+		client: httpclient.New(httpclient.WithOnResponse(telemetry.OnUpstreamResponse)),
+	}
+}
+
+func (p *DeepSeekProvider) Name() string { return "deepseek" }
+
+func (p *DeepSeekProvider) Languages() (source, target []string) {
+	langs := []string{"en", "pt", "es", "fr", "de", "it", "ja", "zh", "ru"}
+	return langs, langs
+}
+
+func (p *DeepSeekProvider) Translate(ctx context.Context, req Request) (Result, error) {
+	ctx, span := telemetry.Tracer().Start(ctx, "deepseek.translate")
+	defer span.End()
+
+	if p.apiKey == "" {
+		return Result{}, errors.New("translator: DEEPSEEK_API_KEY not configured")
+	}
+
+	payload := map[string]interface{}{
+		"text":        req.Text,
+		"source_lang": req.Source,
+		"target_lang": req.Target,
+	}
+
+	var result struct {
+		Data struct {
+			Translations []struct {
+				Text string `json:"text"`
+			} `json:"translations"`
+		} `json:"data"`
+	}
+
+	err := p.client.NewRequest(http.MethodPost, deepSeekAPIURL, "deepseek.translate").
+		Bearer(p.apiKey).
+		JSON(payload).
+		Do(ctx, &result)
+	if err != nil {
+		return Result{}, err
+	}
+	if len(result.Data.Translations) == 0 {
+		return Result{}, errors.New("translator: deepseek returned no translations")
+	}
+
+	return Result{Text: result.Data.Translations[0].Text}, nil
+}
+
+func init() {
+	Register(NewDeepSeekProvider())
+}
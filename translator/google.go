@@ -0,0 +1,77 @@
+package translator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/LesterCerioli/Translator-API-Golang/httpclient"
+	"github.com/LesterCerioli/Translator-API-Golang/telemetry"
+)
+
+const googleTranslateAPIURL = "https://translation.googleapis.com/language/translate/v2"
+
+// GoogleProvider talks to the Google Cloud Translation v2 API.
+type GoogleProvider struct {
+	apiKey string
+	client *httpclient.Client
+}
+
+// NewGoogleProvider builds a GoogleProvider, reading the API key from
+// GOOGLE_TRANSLATE_API_KEY.
+func NewGoogleProvider() *GoogleProvider {
+	return &GoogleProvider{
+		apiKey: os.Getenv("GOOGLE_TRANSLATE_API_KEY"),
+		client: httpclient.New(httpclient.WithOnResponse(telemetry.OnUpstreamResponse)),
+	}
+}
+
+func (p *GoogleProvider) Name() string { return "google" }
+
+func (p *GoogleProvider) Languages() (source, target []string) {
+	langs := []string{"en", "pt", "es", "fr", "de", "it", "ja", "zh", "ru", "ar", "he", "fa"}
+	return langs, langs
+}
+
+func (p *GoogleProvider) Translate(ctx context.Context, req Request) (Result, error) {
+	if p.apiKey == "" {
+		return Result{}, errors.New("translator: GOOGLE_TRANSLATE_API_KEY not configured")
+	}
+
+	payload := map[string]interface{}{
+		"q":      req.Text,
+		"target": req.Target,
+		"format": "text",
+	}
+	if req.Source != "" {
+		payload["source"] = req.Source
+	}
+
+	endpoint := googleTranslateAPIURL + "?key=" + url.QueryEscape(p.apiKey)
+
+	var result struct {
+		Data struct {
+			Translations []struct {
+				TranslatedText string `json:"translatedText"`
+			} `json:"translations"`
+		} `json:"data"`
+	}
+
+	err := p.client.NewRequest(http.MethodPost, endpoint, "google.translate").
+		JSON(payload).
+		Do(ctx, &result)
+	if err != nil {
+		return Result{}, err
+	}
+	if len(result.Data.Translations) == 0 {
+		return Result{}, errors.New("translator: google returned no translations")
+	}
+
+	return Result{Text: result.Data.Translations[0].TranslatedText}, nil
+}
+
+func init() {
+	Register(NewGoogleProvider())
+}
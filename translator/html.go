@@ -0,0 +1,235 @@
+package translator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/LesterCerioli/Translator-API-Golang/telemetry"
+)
+
+// rtlLanguages are rendered right-to-left, for the <html dir="..."> we emit.
+var rtlLanguages = map[string]bool{
+	"ar": true, "he": true, "fa": true,
+}
+
+// noTranslateTags are elements whose subtree is never translated: code the
+// reader needs verbatim, plus non-prose <script>/<style> bodies.
+var noTranslateTags = map[string]bool{
+	"code": true, "pre": true, "script": true, "style": true,
+}
+
+// rawTextTags can't contain child elements, so a translated text node
+// inside one can't be wrapped in a data-original span the way ordinary
+// prose can.
+var rawTextTags = map[string]bool{
+	"title": true, "textarea": true, "option": true,
+}
+
+// voidTags are elements the HTML spec forbids a closing tag for. The
+// tokenizer emits them as StartTagToken even when written without a
+// trailing "/>", with no EndTagToken ever following, so they must not be
+// pushed onto the tag/lang/skip stacks alongside real elements.
+var voidTags = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true,
+	"hr": true, "img": true, "input": true, "link": true, "meta": true,
+	"param": true, "source": true, "track": true, "wbr": true,
+}
+
+type streamToken struct {
+	tt  html.TokenType
+	raw html.Token
+}
+
+// ProcessHTML streams htmlContent through the tokenizer rather than
+// building a full node tree, translating text as it goes while respecting
+// standard i18n signals: it skips subtrees marked translate="no", inside
+// <code>/<pre>/<script>/<style>, or with class="notranslate"; it honors
+// lang attributes on ancestors so regions already in the target language
+// are left untouched; and it emits <html lang="..." dir="..."> with the
+// correct direction for RTL targets. Translated nodes are wrapped in a span
+// with a data-original attribute so a companion client script can toggle
+// back to the source text; inside <title>, <textarea> and <option> the
+// translated text is written bare, without that span.
+func ProcessHTML(ctx context.Context, pool *Pool, htmlContent, source, target string) (string, error) {
+	ctx, span := telemetry.Tracer().Start(ctx, "translator.process_html")
+	defer span.End()
+
+	z := html.NewTokenizer(strings.NewReader(htmlContent))
+
+	var tokens []streamToken
+	var segments []Segment
+	var tagStack []string
+	var langStack []string
+	var skipStack []bool // prior currentSkip, restored when the element closes
+
+	currentLang := source
+	currentSkip := false
+
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			if err := z.Err(); err != nil && err != io.EOF {
+				return "", fmt.Errorf("translator: tokenizing HTML: %w", err)
+			}
+			break
+		}
+		t := z.Token()
+
+		switch tt {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			skip := currentSkip || noTranslateTags[t.Data]
+			elemLang := currentLang
+			for _, a := range t.Attr {
+				switch a.Key {
+				case "translate":
+					if a.Val == "no" {
+						skip = true
+					}
+				case "class":
+					if hasClass(a.Val, "notranslate") {
+						skip = true
+					}
+				case "lang":
+					elemLang = a.Val
+				}
+			}
+
+			if tt == html.StartTagToken && !voidTags[t.Data] {
+				tagStack = append(tagStack, t.Data)
+				langStack = append(langStack, currentLang)
+				skipStack = append(skipStack, currentSkip)
+				currentLang = elemLang
+				currentSkip = skip
+			}
+			tokens = append(tokens, streamToken{tt: tt, raw: t})
+
+		case html.EndTagToken:
+			tokens = append(tokens, streamToken{tt: tt, raw: t})
+			if n := len(tagStack); n > 0 {
+				tagStack = tagStack[:n-1]
+			}
+			if n := len(langStack); n > 0 {
+				currentLang = langStack[n-1]
+				langStack = langStack[:n-1]
+			}
+			if n := len(skipStack); n > 0 {
+				currentSkip = skipStack[n-1]
+				skipStack = skipStack[:n-1]
+			}
+
+		case html.TextToken:
+			if !currentSkip && strings.TrimSpace(t.Data) != "" && !strings.EqualFold(currentLang, target) {
+				segments = append(segments, Segment{Index: len(tokens), Text: t.Data})
+			}
+			tokens = append(tokens, streamToken{tt: tt, raw: t})
+
+		default:
+			tokens = append(tokens, streamToken{tt: tt, raw: t})
+		}
+	}
+
+	var translated []string
+	if len(segments) > 0 {
+		var err error
+		translated, err = pool.TranslateBatch(ctx, segments, source, target)
+		if err != nil {
+			return "", fmt.Errorf("translator: translating HTML text nodes: %w", err)
+		}
+	}
+	byIndex := make(map[int]string, len(segments))
+	for i, s := range segments {
+		byIndex[s.Index] = translated[i]
+	}
+
+	var buf bytes.Buffer
+	var parentStack []string
+	for i, tk := range tokens {
+		switch tk.tt {
+		case html.StartTagToken:
+			if !voidTags[tk.raw.Data] {
+				parentStack = append(parentStack, tk.raw.Data)
+			}
+			if tk.raw.DataAtom == atom.Html {
+				writeHTMLOpenTag(&buf, tk.raw, target)
+				continue
+			}
+			buf.WriteString(tk.raw.String())
+
+		case html.EndTagToken:
+			if n := len(parentStack); n > 0 {
+				parentStack = parentStack[:n-1]
+			}
+			buf.WriteString(tk.raw.String())
+
+		case html.TextToken:
+			newText, ok := byIndex[i]
+			if !ok {
+				buf.WriteString(tk.raw.String())
+				continue
+			}
+			if n := len(parentStack); n > 0 && rawTextTags[parentStack[n-1]] {
+				buf.WriteString(html.EscapeString(newText))
+				continue
+			}
+			buf.WriteString(`<span data-original="`)
+			buf.WriteString(html.EscapeString(tk.raw.Data))
+			buf.WriteString(`">`)
+			buf.WriteString(html.EscapeString(newText))
+			buf.WriteString(`</span>`)
+
+		default:
+			buf.WriteString(tk.raw.String())
+		}
+	}
+
+	return buf.String(), nil
+}
+
+// hasClass reports whether want appears as a whitespace-separated token in
+// classAttr.
+func hasClass(classAttr, want string) bool {
+	for _, c := range strings.Fields(classAttr) {
+		if c == want {
+			return true
+		}
+	}
+	return false
+}
+
+// writeHTMLOpenTag re-emits a <html> start tag, overriding or adding lang
+// and dir so translated documents declare the target language and correct
+// text direction.
+func writeHTMLOpenTag(buf *bytes.Buffer, t html.Token, target string) {
+	dir := "ltr"
+	if rtlLanguages[target] {
+		dir = "rtl"
+	}
+
+	buf.WriteString("<html")
+	wroteLang, wroteDir := false, false
+	for _, a := range t.Attr {
+		switch a.Key {
+		case "lang":
+			fmt.Fprintf(buf, ` lang="%s"`, html.EscapeString(target))
+			wroteLang = true
+		case "dir":
+			fmt.Fprintf(buf, ` dir="%s"`, html.EscapeString(dir))
+			wroteDir = true
+		default:
+			fmt.Fprintf(buf, ` %s="%s"`, a.Key, html.EscapeString(a.Val))
+		}
+	}
+	if !wroteLang {
+		fmt.Fprintf(buf, ` lang="%s"`, html.EscapeString(target))
+	}
+	if !wroteDir {
+		fmt.Fprintf(buf, ` dir="%s"`, html.EscapeString(dir))
+	}
+	buf.WriteString(">")
+}
@@ -0,0 +1,69 @@
+package translator
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// stubProvider returns the input text upper-cased, so tests can assert on
+// translation without hitting a real network provider.
+type stubProvider struct{}
+
+func (stubProvider) Name() string { return "stub" }
+
+func (stubProvider) Languages() (source, target []string) {
+	return []string{"en"}, []string{"en"}
+}
+
+func (stubProvider) Translate(ctx context.Context, req Request) (Result, error) {
+	return Result{Text: strings.ToUpper(req.Text)}, nil
+}
+
+func newTestPool(t *testing.T) *Pool {
+	t.Helper()
+	pool, err := NewPool(stubProvider{}, 1, nil, 0)
+	if err != nil {
+		t.Fatalf("NewPool: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// TestProcessHTML_VoidElementDoesNotLeakSkipState reproduces the bug where a
+// bare void element (e.g. <br>, tokenized as a StartTagToken with no
+// matching EndTagToken) consumed the end tag belonging to its actual
+// ancestor, leaving currentSkip stuck at whatever the <pre>/<code> block set
+// it to. Text after the block must still be translated.
+func TestProcessHTML_VoidElementDoesNotLeakSkipState(t *testing.T) {
+	pool := newTestPool(t)
+
+	in := `<html><body><pre><code>foo<br>bar</code></pre><p>z</p></body></html>`
+	out, err := ProcessHTML(context.Background(), pool, in, "en", "fr")
+	if err != nil {
+		t.Fatalf("ProcessHTML: %v", err)
+	}
+
+	if !strings.Contains(out, ">Z<") {
+		t.Fatalf("expected <p>z</p> to be translated to Z, got: %s", out)
+	}
+	if strings.Contains(out, "FOO") || strings.Contains(out, "BAR") {
+		t.Fatalf("code block contents must never be translated, got: %s", out)
+	}
+}
+
+// TestProcessHTML_VoidElementDoesNotLeakLangState is the analogous case for
+// an ancestor lang attribute rather than a skip flag.
+func TestProcessHTML_VoidElementDoesNotLeakLangState(t *testing.T) {
+	pool := newTestPool(t)
+
+	in := `<html><body><div lang="fr">x<br>y</div><p>z</p></body></html>`
+	out, err := ProcessHTML(context.Background(), pool, in, "en", "fr")
+	if err != nil {
+		t.Fatalf("ProcessHTML: %v", err)
+	}
+
+	if !strings.Contains(out, ">Z<") {
+		t.Fatalf("expected <p>z</p> (outside the lang=\"fr\" div) to be translated, got: %s", out)
+	}
+}
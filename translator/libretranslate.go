@@ -0,0 +1,81 @@
+package translator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+
+	"github.com/LesterCerioli/Translator-API-Golang/httpclient"
+	"github.com/LesterCerioli/Translator-API-Golang/telemetry"
+)
+
+const defaultLibreTranslateURL = "https://libretranslate.com/translate"
+
+// LibreTranslateProvider talks to a LibreTranslate instance. The instance
+// URL is configurable via LIBRETRANSLATE_URL so self-hosted deployments can
+// be used instead of the public one.
+type LibreTranslateProvider struct {
+	endpoint string
+	apiKey   string
+	client   *httpclient.Client
+}
+
+// NewLibreTranslateProvider builds a LibreTranslateProvider, reading the
+// instance URL from LIBRETRANSLATE_URL and the optional API key from
+// LIBRETRANSLATE_API_KEY.
+func NewLibreTranslateProvider() *LibreTranslateProvider {
+	endpoint := os.Getenv("LIBRETRANSLATE_URL")
+	if endpoint == "" {
+		endpoint = defaultLibreTranslateURL
+	}
+	return &LibreTranslateProvider{
+		endpoint: endpoint,
+		apiKey:   os.Getenv("LIBRETRANSLATE_API_KEY"),
+		client:   httpclient.New(httpclient.WithOnResponse(telemetry.OnUpstreamResponse)),
+	}
+}
+
+func (p *LibreTranslateProvider) Name() string { return "libretranslate" }
+
+func (p *LibreTranslateProvider) Languages() (source, target []string) {
+	langs := []string{"en", "pt", "es", "fr", "de", "it", "ja", "zh", "ru", "ar"}
+	return langs, langs
+}
+
+func (p *LibreTranslateProvider) Translate(ctx context.Context, req Request) (Result, error) {
+	source := req.Source
+	if source == "" {
+		source = "auto"
+	}
+
+	payload := map[string]interface{}{
+		"q":      req.Text,
+		"source": source,
+		"target": req.Target,
+		"format": "text",
+	}
+	if p.apiKey != "" {
+		payload["api_key"] = p.apiKey
+	}
+
+	var result struct {
+		TranslatedText string `json:"translatedText"`
+	}
+
+	err := p.client.NewRequest(http.MethodPost, p.endpoint, "libretranslate.translate").
+		JSON(payload).
+		Do(ctx, &result)
+	if err != nil {
+		return Result{}, err
+	}
+	if result.TranslatedText == "" {
+		return Result{}, errors.New("translator: libretranslate returned no translation")
+	}
+
+	return Result{Text: result.TranslatedText}, nil
+}
+
+func init() {
+	Register(NewLibreTranslateProvider())
+}
@@ -0,0 +1,219 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/panjf2000/ants/v2"
+
+	"github.com/LesterCerioli/Translator-API-Golang/cache"
+	"github.com/LesterCerioli/Translator-API-Golang/telemetry"
+)
+
+// defaultPoolSize bounds how many translation calls run concurrently so a
+// single large page can't fan out into thousands of goroutines hitting the
+// provider at once.
+const defaultPoolSize = 32
+
+// coalesceSeparator joins short adjacent text segments into one batched
+// translation request. It's chosen to be vanishingly unlikely to appear in
+// ordinary HTML text.
+const coalesceSeparator = "⁣⁣"
+
+// maxBatchRunes caps how much text a single coalesced batch carries, so one
+// oversized segment doesn't force all its neighbours to wait on it.
+const maxBatchRunes = 400
+
+// Pool runs Provider.Translate calls across a bounded goroutine pool,
+// coalescing runs of short adjacent segments into a single upstream request.
+// When a cache.Store is configured, individual segments are looked up and
+// stored there first so repeated text (nav bars, footers, ...) doesn't
+// round-trip to the provider on every page.
+type Pool struct {
+	provider Provider
+	workers  *ants.Pool
+	cache    cache.Store
+	cacheTTL time.Duration
+}
+
+// NewPool creates a Pool backed by an ants worker pool of the given size.
+// size <= 0 uses defaultPoolSize. store may be nil to disable caching.
+func NewPool(provider Provider, size int, store cache.Store, ttl time.Duration) (*Pool, error) {
+	if size <= 0 {
+		size = defaultPoolSize
+	}
+	workers, err := ants.NewPool(size)
+	if err != nil {
+		return nil, fmt.Errorf("translator: creating worker pool: %w", err)
+	}
+	return &Pool{provider: provider, workers: workers, cache: store, cacheTTL: ttl}, nil
+}
+
+// Close releases the underlying worker pool.
+func (p *Pool) Close() {
+	p.workers.Release()
+}
+
+// Segment is one unit of translatable text. Index is the caller's own
+// correlation id (e.g. a position in an HTML token stream) for mapping a
+// translated result back to its origin; Pool never uses it as a position
+// into its own internal slices.
+type Segment struct {
+	Index int
+	Text  string
+}
+
+// batchItem pairs a Segment with its position in the slice passed to
+// TranslateBatch, which is what the result actually gets written back to
+// (Segment.Index is the caller's id and is unrelated to that position).
+type batchItem struct {
+	pos int
+	seg Segment
+}
+
+type batch struct {
+	items []batchItem
+}
+
+// coalesce groups adjacent segments into batches of at most maxBatchRunes
+// runes of text so they can be translated in a single upstream call. A
+// segment longer than maxBatchRunes forms a batch on its own.
+func coalesce(items []batchItem) []batch {
+	var batches []batch
+	var cur batch
+	curLen := 0
+	for _, it := range items {
+		n := utf8.RuneCountInString(it.seg.Text)
+		if curLen > 0 && curLen+n > maxBatchRunes {
+			batches = append(batches, cur)
+			cur = batch{}
+			curLen = 0
+		}
+		cur.items = append(cur.items, it)
+		curLen += n
+	}
+	if len(cur.items) > 0 {
+		batches = append(batches, cur)
+	}
+	return batches
+}
+
+// TranslateBatch translates segments concurrently across the pool,
+// coalescing short adjacent segments into single requests to cut down on
+// round trips to the provider. The returned slice is positionally aligned
+// with segments (result[i] is the translation of segments[i]), regardless
+// of what each Segment's Index field holds.
+func (p *Pool) TranslateBatch(ctx context.Context, segments []Segment, source, target string) ([]string, error) {
+	out := make([]string, len(segments))
+
+	pending := make([]batchItem, 0, len(segments))
+	for i, s := range segments {
+		if p.cache == nil {
+			pending = append(pending, batchItem{pos: i, seg: s})
+			continue
+		}
+		cached, ok, err := p.cache.Get(ctx, cache.HashKey(s.Text, target))
+		if err == nil && ok {
+			telemetry.RecordCacheLookup(true)
+			out[i] = cached
+			continue
+		}
+		telemetry.RecordCacheLookup(false)
+		pending = append(pending, batchItem{pos: i, seg: s})
+	}
+
+	if len(pending) == 0 {
+		return out, nil
+	}
+
+	batches := coalesce(pending)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+
+	for _, b := range batches {
+		b := b
+		wg.Add(1)
+		err := p.workers.Submit(func() {
+			defer wg.Done()
+			if err := p.translateBatch(ctx, b, source, target, out); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		})
+		if err != nil {
+			wg.Done()
+			return nil, fmt.Errorf("translator: submitting batch: %w", err)
+		}
+	}
+
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return out, nil
+}
+
+func (p *Pool) translateBatch(ctx context.Context, b batch, source, target string, out []string) error {
+	if len(b.items) == 1 {
+		it := b.items[0]
+		res, err := p.provider.Translate(ctx, Request{Text: it.seg.Text, Source: source, Target: target})
+		telemetry.RecordTranslation(p.provider.Name(), source, target, len(it.seg.Text), err)
+		if err != nil {
+			return err
+		}
+		out[it.pos] = res.Text
+		p.store(ctx, it.seg.Text, target, res.Text)
+		return nil
+	}
+
+	texts := make([]string, len(b.items))
+	for i, it := range b.items {
+		texts[i] = it.seg.Text
+	}
+	joined := strings.Join(texts, coalesceSeparator)
+
+	res, err := p.provider.Translate(ctx, Request{Text: joined, Source: source, Target: target})
+	telemetry.RecordTranslation(p.provider.Name(), source, target, len(joined), err)
+	if err != nil {
+		return err
+	}
+
+	parts := strings.Split(res.Text, coalesceSeparator)
+	if len(parts) != len(b.items) {
+		// The provider didn't preserve the separator faithfully (some
+		// engines normalize whitespace). Fall back to translating each
+		// segment individually rather than losing or misaligning text.
+		for _, it := range b.items {
+			r, err := p.provider.Translate(ctx, Request{Text: it.seg.Text, Source: source, Target: target})
+			if err != nil {
+				return err
+			}
+			out[it.pos] = r.Text
+			p.store(ctx, it.seg.Text, target, r.Text)
+		}
+		return nil
+	}
+
+	for i, it := range b.items {
+		out[it.pos] = parts[i]
+		p.store(ctx, it.seg.Text, target, parts[i])
+	}
+	return nil
+}
+
+// store caches a translated segment, if a cache.Store is configured.
+func (p *Pool) store(ctx context.Context, text, target, translated string) {
+	if p.cache == nil {
+		return
+	}
+	_ = p.cache.Set(ctx, cache.HashKey(text, target), translated, p.cacheTTL)
+}
@@ -0,0 +1,35 @@
+package translator
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// TestTranslateBatch_IndexIsNotAPosition reproduces the panic from passing
+// segments whose Index values are a caller-side correlation id (as
+// ProcessHTML's token-stream position is) rather than a position in the
+// segments slice itself. TranslateBatch must write results positionally,
+// never via out[s.Index].
+func TestTranslateBatch_IndexIsNotAPosition(t *testing.T) {
+	pool := newTestPool(t)
+
+	segments := []Segment{
+		{Index: 57, Text: "hello"},
+		{Index: 212, Text: "world"},
+	}
+
+	out, err := pool.TranslateBatch(context.Background(), segments, "en", "fr")
+	if err != nil {
+		t.Fatalf("TranslateBatch: %v", err)
+	}
+	if len(out) != len(segments) {
+		t.Fatalf("len(out) = %d, want %d", len(out), len(segments))
+	}
+	if out[0] != strings.ToUpper(segments[0].Text) {
+		t.Fatalf("out[0] = %q, want %q", out[0], strings.ToUpper(segments[0].Text))
+	}
+	if out[1] != strings.ToUpper(segments[1].Text) {
+		t.Fatalf("out[1] = %q, want %q", out[1], strings.ToUpper(segments[1].Text))
+	}
+}
@@ -0,0 +1,70 @@
+// Package translator abstracts translation engines behind a single
+// Provider interface so the server can support several backends
+// (DeepSeek, LibreTranslate, Google, Reverso) selectable via config or the
+// `?engine=` query parameter, and can fan work out across a bounded
+// worker pool instead of translating serially.
+package translator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Request is one unit of work submitted to a Provider.
+type Request struct {
+	Text   string
+	Source string
+	Target string
+}
+
+// Result is what a Provider returns for a Request.
+type Result struct {
+	Text string
+}
+
+// Provider is a translation engine.
+type Provider interface {
+	// Name is the identifier used in config and the `engine` query param.
+	Name() string
+	// Translate translates req.Text from req.Source to req.Target.
+	Translate(ctx context.Context, req Request) (Result, error)
+	// Languages reports the source and target language codes this
+	// provider supports.
+	Languages() (source []string, target []string)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Provider{}
+)
+
+// Register makes a Provider available by name. Providers call this from an
+// init() in the file that implements them.
+func Register(p Provider) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[p.Name()] = p
+}
+
+// Get looks up a registered Provider by name.
+func Get(name string) (Provider, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	p, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("translator: unknown engine %q", name)
+	}
+	return p, nil
+}
+
+// List returns every registered Provider, for the /api/engines endpoint.
+func List() []Provider {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	out := make([]Provider, 0, len(registry))
+	for _, p := range registry {
+		out = append(out, p)
+	}
+	return out
+}
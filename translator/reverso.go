@@ -0,0 +1,68 @@
+package translator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/LesterCerioli/Translator-API-Golang/httpclient"
+	"github.com/LesterCerioli/Translator-API-Golang/telemetry"
+)
+
+// reversoAPIURL is the unofficial endpoint behind translate.reverso.net,
+// the same one the Mozhi translation proxy uses for its Reverso engine.
+const reversoAPIURL = "https://api.reverso.net/translate/v1/translation"
+
+// ReversoProvider talks to Reverso's unofficial translation API.
+type ReversoProvider struct {
+	client *httpclient.Client
+}
+
+// NewReversoProvider builds a ReversoProvider.
+func NewReversoProvider() *ReversoProvider {
+	return &ReversoProvider{client: httpclient.New(httpclient.WithOnResponse(telemetry.OnUpstreamResponse))}
+}
+
+func (p *ReversoProvider) Name() string { return "reverso" }
+
+func (p *ReversoProvider) Languages() (source, target []string) {
+	langs := []string{"en", "pt", "es", "fr", "de", "it", "ja", "zh", "ru", "ar"}
+	return langs, langs
+}
+
+func (p *ReversoProvider) Translate(ctx context.Context, req Request) (Result, error) {
+	source := req.Source
+	if source == "" {
+		source = "auto"
+	}
+
+	payload := map[string]interface{}{
+		"input":  []string{req.Text},
+		"from":   source,
+		"to":     req.Target,
+		"format": "text",
+		"options": map[string]interface{}{
+			"sentenceSplitter": false,
+		},
+	}
+
+	var result struct {
+		Translation []string `json:"translation"`
+	}
+
+	err := p.client.NewRequest(http.MethodPost, reversoAPIURL, "reverso.translate").
+		JSON(payload).
+		Do(ctx, &result)
+	if err != nil {
+		return Result{}, err
+	}
+	if len(result.Translation) == 0 {
+		return Result{}, errors.New("translator: reverso returned no translation")
+	}
+
+	return Result{Text: result.Translation[0]}, nil
+}
+
+func init() {
+	Register(NewReversoProvider())
+}